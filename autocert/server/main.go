@@ -0,0 +1,52 @@
+// Command server is an alternative to basic/server that obtains its
+// certificate automatically from Let's Encrypt via ACME instead of reading
+// a hardcoded server.crt/server.key pair.
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+func main() {
+	domains := flag.String("domains", "", "comma-separated list of domains to obtain certificates for")
+	cacheDir := flag.String("cache-dir", "autocert-cache", "directory to cache issued certificates in")
+	staging := flag.Bool("staging", false, "use Let's Encrypt's staging directory instead of production")
+	flag.Parse()
+
+	if *domains == "" {
+		log.Fatal("-domains is required")
+	}
+
+	m := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(strings.Split(*domains, ",")...),
+		Cache:      autocert.DirCache(*cacheDir),
+	}
+	if *staging {
+		m.Client = &acme.Client{DirectoryURL: "https://acme-staging-v02.api.letsencrypt.org/directory"}
+	}
+
+	srv := &http.Server{
+		Addr:      ":8443",
+		Handler:   &handler{},
+		TLSConfig: &tls.Config{GetCertificate: m.GetCertificate},
+	}
+
+	// ACME's HTTP-01 challenge is served over plain HTTP on :80.
+	go log.Fatal(http.ListenAndServe(":80", m.HTTPHandler(nil)))
+
+	log.Fatal(srv.ListenAndServeTLS("", ""))
+}
+
+type handler struct{}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Write([]byte("PONG\n"))
+}
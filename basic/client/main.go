@@ -0,0 +1,62 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"golang.org/x/net/http2"
+)
+
+func main() {
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{},
+		},
+	}
+
+	resp, err := client.Get("https://127.0.0.1:8443")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	htmlData, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer resp.Body.Close()
+	fmt.Printf("%v\n", resp.Status)
+	fmt.Printf(string(htmlData))
+
+	// http2.Transport forces an h2 connection (including over a plain TLS
+	// dial, without relying on the default Transport's ALPN upgrade path)
+	// so the response can be checked against the HTTP/2 protocol string.
+	h2Client := &http.Client{
+		Transport: &http2.Transport{
+			TLSClientConfig: &tls.Config{},
+		},
+	}
+
+	h2Resp, err := h2Client.Get("https://127.0.0.1:8443")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer h2Resp.Body.Close()
+
+	if h2Resp.Proto != "HTTP/2.0" {
+		log.Fatalf("expected HTTP/2.0, got %s", h2Resp.Proto)
+	}
+
+	h2Data, err := ioutil.ReadAll(h2Resp.Body)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%v over %v\n", h2Resp.Status, h2Resp.Proto)
+	fmt.Printf(string(h2Data))
+}
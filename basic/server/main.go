@@ -0,0 +1,58 @@
+package main
+
+import (
+	"crypto/tls"
+	"log"
+	"net/http"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	cfg := &tls.Config{}
+	srv := &http.Server{
+		Addr:      ":8443",
+		Handler:   &handler{},
+		TLSConfig: cfg,
+	}
+
+	// Explicitly configure HTTP/2 support for the TLS listener rather than
+	// relying on ListenAndServeTLS to do it implicitly, so ALPN negotiation
+	// for "h2" and the http2.Server settings are visible here.
+	if err := http2.ConfigureServer(srv, &http2.Server{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// A cleartext h2c listener on a second port lets the same handler be
+	// exercised over HTTP/1.1, h2 and h2c for comparison.
+	h2cSrv := &http.Server{
+		Addr:    ":8080",
+		Handler: h2c.NewHandler(&handler{}, &http2.Server{}),
+	}
+	go func() {
+		log.Fatal(h2cSrv.ListenAndServe())
+	}()
+
+	log.Fatal(srv.ListenAndServeTLS("server.crt", "server.key"))
+}
+
+type handler struct{}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// The pushed stream re-enters ServeHTTP, so this check must come before
+	// the Push call below or every request would push a copy of itself,
+	// recursively, until the connection's push budget is exhausted.
+	if req.URL.Path == "/push-asset.txt" {
+		w.Write([]byte("pushed asset\n"))
+		return
+	}
+
+	if pusher, ok := w.(http.Pusher); ok {
+		if err := pusher.Push("/push-asset.txt", nil); err != nil {
+			log.Printf("push failed: %v", err)
+		}
+	}
+
+	w.Write([]byte("PONG\n"))
+}
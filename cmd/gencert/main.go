@@ -0,0 +1,198 @@
+// Command gencert generates a self-signed or CA-signed TLS certificate,
+// replicating the openssl genrsa / ecparam / req -x509 recipes used
+// throughout this repo's examples without requiring openssl to be
+// installed. It supports RSA (2048 bits and up), ECDSA P-384 and Ed25519
+// keys, and one or more Subject Alternative Names.
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+	host := flag.String("host", "", "comma-separated hostnames and IPs to generate the certificate for")
+	validFor := flag.Duration("duration", 825*24*time.Hour, "how long the certificate is valid for")
+	isCA := flag.Bool("ca", false, "generate a CA certificate that can sign other certificates")
+	keyType := flag.String("key-type", "rsa", "key type to generate: rsa, ecdsa or ed25519")
+	rsaBits := flag.Int("rsa-bits", 2048, "size of the RSA key to generate, when -key-type=rsa")
+	certOut := flag.String("cert-out", "cert.pem", "path to write the certificate to")
+	keyOut := flag.String("key-out", "key.pem", "path to write the private key to")
+	caCertIn := flag.String("ca-cert", "", "path to a PEM CA certificate to sign with, instead of self-signing")
+	caKeyIn := flag.String("ca-key", "", "path to the PEM private key matching -ca-cert")
+	flag.Parse()
+
+	if *host == "" && !*isCA {
+		log.Fatal("-host is required unless -ca is set")
+	}
+
+	pub, priv, err := generateKey(*keyType, *rsaBits)
+	if err != nil {
+		log.Fatalf("generate key: %v", err)
+	}
+
+	template, err := certTemplate(*host, *validFor, *isCA)
+	if err != nil {
+		log.Fatalf("build certificate template: %v", err)
+	}
+
+	parent := template
+	signerKey := priv
+	if *caCertIn != "" {
+		caCert, caKey, err := loadCA(*caCertIn, *caKeyIn)
+		if err != nil {
+			log.Fatalf("load CA: %v", err)
+		}
+		parent = caCert
+		signerKey = caKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signerKey)
+	if err != nil {
+		log.Fatalf("create certificate: %v", err)
+	}
+
+	if err := writePEM(*certOut, "CERTIFICATE", der); err != nil {
+		log.Fatalf("write certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		log.Fatalf("marshal private key: %v", err)
+	}
+	if err := writePEM(*keyOut, "PRIVATE KEY", keyDER); err != nil {
+		log.Fatalf("write private key: %v", err)
+	}
+
+	log.Printf("wrote %s and %s", *certOut, *keyOut)
+}
+
+// generateKey returns the public half (for embedding in the certificate)
+// and the private key (for signing and for PKCS#8 export).
+func generateKey(keyType string, rsaBits int) (pub, priv any, err error) {
+	switch keyType {
+	case "rsa":
+		if rsaBits < 2048 {
+			rsaBits = 2048
+		}
+		key, err := rsa.GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &key.PublicKey, key, nil
+	case "ecdsa":
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &key.PublicKey, key, nil
+	case "ed25519":
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pubKey, privKey, nil
+	default:
+		return nil, nil, &unsupportedKeyTypeError{keyType}
+	}
+}
+
+type unsupportedKeyTypeError struct{ keyType string }
+
+func (e *unsupportedKeyTypeError) Error() string {
+	return "unsupported -key-type " + e.keyType + " (want rsa, ecdsa or ed25519)"
+}
+
+func certTemplate(hosts string, validFor time.Duration, isCA bool) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	commonName := "gencert self-signed certificate"
+	var dnsNames []string
+	var ipAddresses []net.IP
+	if hosts != "" {
+		for _, h := range strings.Split(hosts, ",") {
+			if ip := net.ParseIP(h); ip != nil {
+				ipAddresses = append(ipAddresses, ip)
+			} else {
+				dnsNames = append(dnsNames, h)
+			}
+		}
+		commonName = strings.Split(hosts, ",")[0]
+	}
+
+	notBefore := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(validFor),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              dnsNames,
+		IPAddresses:           ipAddresses,
+	}
+
+	if isCA {
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+
+	return template, nil
+}
+
+func loadCA(certPath, keyPath string) (*x509.Certificate, any, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("no PEM data found in %s", certPath)
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("no PEM data found in %s", keyPath)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: blockType, Bytes: der})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/jcbsmpsn/golang-https-example/mtlspki"
+)
+
+func main() {
+	cfg, err := mtlspki.ClientConfig("mtls/certs/client.crt", "mtls/certs/client.key", "mtls/certs/ca.crt")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: cfg,
+		},
+	}
+
+	resp, err := client.Get("https://127.0.0.1:8444")
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%v\n", resp.Status)
+	fmt.Printf(string(body))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/jcbsmpsn/golang-https-example/mtlspki"
+)
+
+func main() {
+	cfg, err := mtlspki.ServerConfig("mtls/certs/server.crt", "mtls/certs/server.key", "mtls/certs/ca.crt")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := &http.Server{
+		Addr:      ":8444",
+		Handler:   &handler{},
+		TLSConfig: cfg,
+	}
+	log.Fatal(srv.ListenAndServeTLS("", ""))
+}
+
+type handler struct{}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	cn := req.TLS.PeerCertificates[0].Subject.CommonName
+	fmt.Fprintf(w, "PONG, hello %s\n", cn)
+}
@@ -0,0 +1,25 @@
+// Command server demonstrates tlspresets: it loads server.crt/server.key
+// into the Intermediate tier's tls.Config and wraps the handler with HSTS.
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/jcbsmpsn/golang-https-example/tlspresets"
+)
+
+func main() {
+	cfg := tlspresets.Intermediate()
+
+	handler := tlspresets.HSTS(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("PONG\n"))
+	}), 63072000) // one non-leap year, the value Mozilla's guideline recommends
+
+	srv := &http.Server{
+		Addr:      ":8446",
+		Handler:   handler,
+		TLSConfig: cfg,
+	}
+	log.Fatal(srv.ListenAndServeTLS("server.crt", "server.key"))
+}
@@ -0,0 +1,120 @@
+// Package unified serves plain HTTP and HTTPS from a single TCP listener by
+// peeking at the first byte of each connection: a TLS ClientHello starts
+// with the handshake record type 0x16, anything else is treated as
+// plaintext HTTP/1.x.
+package unified
+
+import (
+	"bufio"
+	"crypto/tls"
+	"net"
+	"net/http"
+)
+
+const tlsRecordTypeHandshake = 0x16
+
+// Serve listens on addr and dispatches each accepted connection to either
+// plainHandler or tlsHandler depending on whether the connection looks like
+// a TLS handshake. tlsConfig must already carry the server's certificates.
+// It blocks until the underlying listener is closed or errors.
+func Serve(addr string, tlsConfig *tls.Config, plainHandler, tlsHandler http.Handler) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+
+	plainListener := newConnListener(ln.Addr())
+	tlsListener := newConnListener(ln.Addr())
+
+	plainSrv := &http.Server{Handler: plainHandler}
+	tlsSrv := &http.Server{Handler: tlsHandler, TLSConfig: tlsConfig}
+
+	go plainSrv.Serve(plainListener)
+	go tlsSrv.ServeTLS(tlsListener, "", "")
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			plainListener.Close()
+			tlsListener.Close()
+			return err
+		}
+		go route(conn, plainListener, tlsListener)
+	}
+}
+
+// route peeks the first byte of conn and hands it to the matching
+// connListener, wrapped so the peeked byte is replayed on the first Read.
+func route(conn net.Conn, plainListener, tlsListener *connListener) {
+	br := bufio.NewReader(conn)
+	b, err := br.Peek(1)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	sc := &sniffedConn{Conn: conn, r: br}
+	if b[0] == tlsRecordTypeHandshake {
+		tlsListener.submit(sc)
+	} else {
+		plainListener.submit(sc)
+	}
+}
+
+// sniffedConn replays the bytes already consumed by the peek before falling
+// back to reading from the wrapped connection.
+type sniffedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *sniffedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// connListener is a net.Listener whose connections are fed in from the
+// shared accept loop instead of its own socket.
+type connListener struct {
+	addr   net.Addr
+	connCh chan net.Conn
+	closed chan struct{}
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:   addr,
+		connCh: make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *connListener) submit(conn net.Conn) {
+	select {
+	case l.connCh <- conn:
+	case <-l.closed:
+		conn.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	select {
+	case <-l.closed:
+	default:
+		close(l.closed)
+	}
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}